@@ -0,0 +1,55 @@
+package ultrabase64
+
+// Стандартные go test бенчмарки вместо ручного цикла замеров времени.
+// Профилирование подключается штатными флагами go test, без лишнего кода:
+//
+//	go test -bench=. -cpuprofile=cpu.out -memprofile=mem.out
+//	go tool pprof cpu.out
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+var benchSizesMB = []int{1, 10, 50, 100}
+var benchThreadCounts = []int{1, 2, 4, 8}
+
+func benchData(sizeMB int) []byte {
+	data := make([]byte, sizeMB*1024*1024)
+	rand.New(rand.NewSource(42)).Read(data)
+	return data
+}
+
+// BenchmarkEncodeDefault меряет Encode() - путь, который реально использует
+// вызывающий код (сам выбирает число потоков).
+func BenchmarkEncodeDefault(b *testing.B) {
+	for _, sizeMB := range benchSizesMB {
+		data := benchData(sizeMB)
+		b.Run(fmt.Sprintf("%dMB", sizeMB), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = Encode(data)
+			}
+		})
+	}
+}
+
+// BenchmarkEncodeMultithreaded меряет EncodeMultithreaded при фиксированном
+// числе потоков, чтобы видеть, как throughput масштабируется с параллелизмом.
+func BenchmarkEncodeMultithreaded(b *testing.B) {
+	for _, sizeMB := range benchSizesMB {
+		data := benchData(sizeMB)
+		for _, threads := range benchThreadCounts {
+			b.Run(fmt.Sprintf("%dMB/threads=%d", sizeMB, threads), func(b *testing.B) {
+				b.SetBytes(int64(len(data)))
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					_ = EncodeMultithreaded(data, threads)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package ultrabase64
+
+import (
+	"encoding/base64"
+	"sync"
+	"sync/atomic"
+)
+
+// CacheConfig описывает размер L2 на одно ядро, под который подстраивается
+// размер чанка в EncodeMultithreaded/EncodeTo (см. adaptiveChunkSize). L3
+// здесь намеренно не фигурирует: encodeTilesInto всегда пишет каждый тайл
+// сразу в конечный dst, без промежуточного буфера на весь main-part, так что
+// отдельного "режима для L3" не существует - есть только один тайловый путь,
+// и единственный параметр, на который имеет смысл подстраивать chunkSize,
+// это L2 воркера.
+type CacheConfig struct {
+	// L2Bytes - размер L2 на одно ядро.
+	L2Bytes int
+}
+
+// DefaultCacheConfig - консервативная оценка для типичного современного
+// x86/ARM ядра (L2 ~1MB). Go не даёт переносимого способа узнать реальный
+// размер кеша без cgo, поэтому значение по умолчанию подобрано так, чтобы не
+// переполнять кеш на большинстве машин; вызывающий код, которому известна
+// реальная топология, может передать точную цифру через SetCacheConfig.
+var DefaultCacheConfig = CacheConfig{
+	L2Bytes: 1 << 20, // 1MB
+}
+
+var cacheConfig atomic.Value // CacheConfig
+
+func init() {
+	cacheConfig.Store(DefaultCacheConfig)
+}
+
+// SetCacheConfig переопределяет размеры кеша, используемые EncodeMultithreaded
+// для адаптивного выбора размера чанка. Потокобезопасно.
+func SetCacheConfig(c CacheConfig) {
+	cacheConfig.Store(c)
+}
+
+func currentCacheConfig() CacheConfig {
+	return cacheConfig.Load().(CacheConfig)
+}
+
+// adaptiveChunkSize выбирает размер входного чанка воркера равным половине L2
+// (выходной base64-срез, ~4/3 от входного, в этот бюджет не входит), но не
+// больше MIN_CHUNK_SIZE. Результат всегда кратен 3.
+func adaptiveChunkSize(cfg CacheConfig) int {
+	budget := cfg.L2Bytes / 2 / 3 * 3
+	fixed := (MIN_CHUNK_SIZE / 3) * 3
+	if budget <= 0 || budget > fixed {
+		return fixed
+	}
+	return budget
+}
+
+// encodeTilesInto кодирует mainPart (гарантированно кратную 3 байтам) в
+// режиме "streaming tile": небольшой пул воркеров (numThreads), каждому из
+// которых заранее отдан непрерывный диапазон последовательных тайлов размера
+// chunkSize, пишет закодированные байты прямо в dst[outStart:outEnd] - без
+// промежуточных строк или срезов на тайл и без финальной конкатенации. dst
+// должен вмещать как минимум len(mainPart)/3*4 байт. rawEnc кодирует чанки без
+// паддинга - для кратного 3 байтам mainPart паддинг всё равно не нужен,
+// независимо от паддинга, выбранного для финального хвоста. numThreads <= 0
+// трактуется как 1 воркер, а не как ошибка.
+func encodeTilesInto(dst, mainPart []byte, numThreads, chunkSize int, rawEnc *base64.Encoding) {
+	mainLen := len(mainPart)
+	if mainLen == 0 {
+		return
+	}
+	if numThreads <= 0 {
+		numThreads = 1
+	}
+
+	numTiles := (mainLen + chunkSize - 1) / chunkSize
+	workers := numThreads
+	if workers > numTiles {
+		workers = numTiles
+	}
+	tilesPerWorker := (numTiles + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		startTile := w * tilesPerWorker
+		endTile := startTile + tilesPerWorker
+		if endTile > numTiles {
+			endTile = numTiles
+		}
+		if startTile >= endTile {
+			continue
+		}
+
+		wg.Add(1)
+		go func(startTile, endTile int) {
+			defer wg.Done()
+			for t := startTile; t < endTile; t++ {
+				inStart := t * chunkSize
+				inEnd := inStart + chunkSize
+				if inEnd > mainLen {
+					inEnd = mainLen
+				}
+				outStart := inStart / 3 * 4
+				outEnd := inEnd / 3 * 4
+				tile := mainPart[inStart:inEnd]
+				tileDst := dst[outStart:outEnd]
+
+				rawEnc.Encode(tileDst, tile)
+			}
+		}(startTile, endTile)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,37 @@
+package ultrabase64
+
+import (
+	"encoding/base64"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeTiledMatchesStdlib(t *testing.T) {
+	// Маленький L2 заставляет adaptiveChunkSize выбрать чанк меньше
+	// MIN_CHUNK_SIZE, проверяем, что tiled-запись в результат всё равно
+	// даёт корректный base64.
+	SetCacheConfig(CacheConfig{L2Bytes: 256 * 1024})
+	defer SetCacheConfig(DefaultCacheConfig)
+
+	data := make([]byte, MULTITHREAD_THRESHOLD+5)
+	rand.New(rand.NewSource(7)).Read(data)
+
+	got := EncodeMultithreaded(data, 4)
+	want := base64.StdEncoding.EncodeToString(data)
+	if got != want {
+		t.Fatalf("tiled encode mismatch: got len %d, want len %d", len(got), len(want))
+	}
+}
+
+func TestAdaptiveChunkSizeBounds(t *testing.T) {
+	fixed := (MIN_CHUNK_SIZE / 3) * 3
+
+	if got := adaptiveChunkSize(CacheConfig{L2Bytes: 64 << 20}); got != fixed {
+		t.Fatalf("expected fixed chunk size %d for large L2, got %d", fixed, got)
+	}
+
+	small := adaptiveChunkSize(CacheConfig{L2Bytes: 256 * 1024})
+	if small <= 0 || small > fixed || small%3 != 0 {
+		t.Fatalf("unexpected adaptive chunk size %d", small)
+	}
+}
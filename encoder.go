@@ -0,0 +1,205 @@
+package ultrabase64
+
+import (
+	"errors"
+	"io"
+	"sync"
+)
+
+// errClosed возвращается при попытке писать в уже закрытый Encoder.
+var errClosed = errors.New("ultrabase64: write to closed Encoder")
+
+// EncoderOptions настраивает поведение Encoder.
+type EncoderOptions struct {
+	// Threads ограничивает число воркеров, кодирующих чанки параллельно.
+	// 0 означает "как Encode" (число ядер, не больше MAX_THREADS).
+	Threads int
+	// Encoding - алфавит кодирования. nil означает StdEncoding.
+	Encoding *Encoding
+}
+
+// Encoder - потоковый base64-кодировщик. В отличие от Encode/EncodeMultithreaded
+// он не держит весь вход и весь результат в памяти одновременно: входные байты
+// буферизуются до ближайшей границы в 3 байта, кратной chunkSize, чанки
+// кодируются тем же тайловым воркер-пулом, что и EncodeMultithreaded/EncodeTo
+// (см. encodeTilesInto), и немедленно пишутся в Writer по порядку.
+type Encoder struct {
+	w         io.Writer
+	threads   int
+	chunkSize int
+	maxEncLen int
+	enc       *Encoding
+	bufPool   sync.Pool
+	buf       []byte
+	closed    bool
+	err       error
+}
+
+// NewEncoder создаёт потоковый кодировщик, пишущий в w.
+func NewEncoder(w io.Writer, opts EncoderOptions) *Encoder {
+	threads := opts.Threads
+	if threads <= 0 {
+		threads = optimalThreads()
+	}
+	enc := opts.Encoding
+	if enc == nil {
+		enc = StdEncoding
+	}
+	chunkSize := adaptiveChunkSize(currentCacheConfig())
+	e := &Encoder{
+		w:         w,
+		threads:   threads,
+		chunkSize: chunkSize,
+		enc:       enc,
+		maxEncLen: enc.raw.EncodedLen(chunkSize),
+	}
+	e.bufPool.New = func() interface{} {
+		return make([]byte, e.maxEncLen)
+	}
+	return e
+}
+
+// Write буферизует p и кодирует все накопившиеся полные (кратные 3 байтам) чанки.
+func (e *Encoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	if e.closed {
+		return 0, errClosed
+	}
+
+	e.buf = append(e.buf, p...)
+	if err := e.drainFullChunks(); err != nil {
+		e.err = err
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// drainFullChunks кодирует и пишет все чанки размера chunkSize, накопленные в буфере,
+// оставляя в буфере только неполный остаток.
+func (e *Encoder) drainFullChunks() error {
+	usable := len(e.buf) - len(e.buf)%e.chunkSize
+	if usable == 0 {
+		return nil
+	}
+
+	if err := e.encodeChunks(e.buf[:usable]); err != nil {
+		return err
+	}
+
+	remainder := len(e.buf) - usable
+	copy(e.buf, e.buf[usable:])
+	e.buf = e.buf[:remainder]
+	return nil
+}
+
+// encodeChunks кодирует data (кратное e.chunkSize и тем самым 3 байтам) через
+// encodeTilesInto - тот же тайловый воркер-пул, что использует
+// EncodeMultithreaded/EncodeTo, - в один буфер из e.bufPool и пишет его в w.
+// Благодаря этому Encoder подчиняется SetCacheConfig и не несёт отдельной,
+// расходящейся с остальным пакетом реализации воркер-пула.
+func (e *Encoder) encodeChunks(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	out := e.getBuf(e.enc.raw.EncodedLen(len(data)))
+	encodeTilesInto(out, data, e.threads, e.chunkSize, e.enc.raw)
+	_, err := e.w.Write(out)
+	e.bufPool.Put(out[:cap(out)])
+	return err
+}
+
+// encodeTail кодирует финальный, не кратный chunkSize остаток data целиком
+// (с паддингом, как и положено последнему блоку потока) и пишет результат в
+// w. Остаток всегда короче chunkSize, так что параллелить его нет смысла.
+func (e *Encoder) encodeTail(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	out := e.getBuf(e.enc.full.EncodedLen(len(data)))
+	e.enc.full.Encode(out, data)
+	_, err := e.w.Write(out)
+	e.bufPool.Put(out[:cap(out)])
+	return err
+}
+
+// getBuf возвращает из пула буфер длиной n (ёмкостью не меньше maxEncLen).
+func (e *Encoder) getBuf(n int) []byte {
+	buf := e.bufPool.Get().([]byte)
+	if cap(buf) < n {
+		buf = make([]byte, n)
+	}
+	return buf[:n]
+}
+
+// Flush пишет в Writer всё, что накопилось в полных чанках. Невыровненный
+// остаток (< 3 байт или не кратный chunkSize) дожидается Close, так как его
+// base64-представление (с паддингом) корректно только как последний блок потока.
+func (e *Encoder) Flush() error {
+	if e.err != nil {
+		return e.err
+	}
+	if e.closed {
+		return errClosed
+	}
+	if err := e.drainFullChunks(); err != nil {
+		e.err = err
+		return err
+	}
+	if f, ok := e.w.(interface{ Flush() error }); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Close кодирует оставшийся в буфере хвост (с паддингом, как итоговый блок) и
+// закрывает Encoder. Повторные вызовы Write после Close возвращают ошибку.
+func (e *Encoder) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	if e.err != nil {
+		return e.err
+	}
+
+	if err := e.drainFullChunks(); err != nil {
+		e.err = err
+		return err
+	}
+	if len(e.buf) > 0 {
+		if err := e.encodeTail(e.buf); err != nil {
+			e.err = err
+			return err
+		}
+		e.buf = nil
+	}
+	return nil
+}
+
+// EncodeStream читает r целиком потоком и пишет base64-кодирование в w, не
+// материализуя ни вход, ни выход целиком в памяти. Полезно для файлов и
+// HTTP-тел, не помещающихся в RAM.
+func EncodeStream(r io.Reader, w io.Writer) error {
+	enc := NewEncoder(w, EncoderOptions{})
+
+	buf := make([]byte, MIN_CHUNK_SIZE)
+	for {
+		n, readErr := r.Read(buf)
+		if n > 0 {
+			if _, err := enc.Write(buf[:n]); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+	return enc.Close()
+}
@@ -0,0 +1,85 @@
+package ultrabase64
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math/rand"
+	"testing"
+)
+
+func TestEncoderMatchesStdlib(t *testing.T) {
+	sizes := []int{0, 1, 2, 3, 100, MIN_CHUNK_SIZE - 1, MIN_CHUNK_SIZE, MIN_CHUNK_SIZE + 5, 3*MIN_CHUNK_SIZE + 7}
+
+	for _, size := range sizes {
+		data := make([]byte, size)
+		rand.New(rand.NewSource(int64(size))).Read(data)
+
+		var buf bytes.Buffer
+		enc := NewEncoder(&buf, EncoderOptions{Threads: 4})
+		if _, err := enc.Write(data); err != nil {
+			t.Fatalf("size %d: Write: %v", size, err)
+		}
+		if err := enc.Close(); err != nil {
+			t.Fatalf("size %d: Close: %v", size, err)
+		}
+
+		want := base64.StdEncoding.EncodeToString(data)
+		if got := buf.String(); got != want {
+			t.Fatalf("size %d: got %q, want %q", size, got, want)
+		}
+	}
+}
+
+func TestEncoderRespectsCacheConfig(t *testing.T) {
+	// Маленький L2 заставляет adaptiveChunkSize выбрать чанк меньше
+	// MIN_CHUNK_SIZE; Encoder должен подхватить это значение, а не
+	// кодировать фиксированными MIN_CHUNK_SIZE-чанками.
+	SetCacheConfig(CacheConfig{L2Bytes: 256 * 1024})
+	defer SetCacheConfig(DefaultCacheConfig)
+
+	data := make([]byte, 3*MIN_CHUNK_SIZE+11)
+	rand.New(rand.NewSource(5)).Read(data)
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf, EncoderOptions{Threads: 4})
+	if want := adaptiveChunkSize(CacheConfig{L2Bytes: 256 * 1024}); enc.chunkSize != want || want >= MIN_CHUNK_SIZE {
+		t.Fatalf("chunkSize = %d, want %d (< MIN_CHUNK_SIZE)", enc.chunkSize, want)
+	}
+	if _, err := enc.Write(data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	want := base64.StdEncoding.EncodeToString(data)
+	if got := buf.String(); got != want {
+		t.Fatalf("got len %d, want len %d", len(got), len(want))
+	}
+}
+
+func TestEncoderWriteAfterClose(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf, EncoderOptions{})
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if _, err := enc.Write([]byte("x")); err == nil {
+		t.Fatal("expected error writing after Close")
+	}
+}
+
+func TestEncodeStream(t *testing.T) {
+	data := make([]byte, 2*MIN_CHUNK_SIZE+17)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	var buf bytes.Buffer
+	if err := EncodeStream(bytes.NewReader(data), &buf); err != nil {
+		t.Fatalf("EncodeStream: %v", err)
+	}
+
+	want := base64.StdEncoding.EncodeToString(data)
+	if got := buf.String(); got != want {
+		t.Fatalf("got %q len %d, want len %d", got[:20], len(got), len(want))
+	}
+}
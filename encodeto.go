@@ -0,0 +1,17 @@
+package ultrabase64
+
+// EncodedLen возвращает длину base64-представления n байт входа в формате,
+// который использует StdEncoding. Для других алфавитов см. Encoding.EncodedLen.
+func EncodedLen(n int) int {
+	return StdEncoding.EncodedLen(n)
+}
+
+// EncodeTo кодирует src в base64 (StdEncoding) прямо в dst (который должен
+// быть не короче EncodedLen(len(src))), используя для входов от
+// MULTITHREAD_THRESHOLD столько воркеров, сколько даёт optimalThreads(), и
+// возвращает число записанных байт. В отличие от Encode он не выделяет
+// памяти под результат: каждый воркер пишет свой кусок сразу в dst. Для
+// других алфавитов см. Encoding.EncodeTo.
+func EncodeTo(dst, src []byte) int {
+	return StdEncoding.EncodeTo(dst, src, optimalThreads())
+}
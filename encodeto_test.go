@@ -0,0 +1,24 @@
+package ultrabase64
+
+import (
+	"encoding/base64"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeToMatchesStdlib(t *testing.T) {
+	sizes := []int{0, 1, 2, 3, 100, MULTITHREAD_THRESHOLD + 17}
+
+	for _, size := range sizes {
+		data := make([]byte, size)
+		rand.New(rand.NewSource(int64(size))).Read(data)
+
+		dst := make([]byte, EncodedLen(len(data)))
+		n := EncodeTo(dst, data)
+
+		want := base64.StdEncoding.EncodeToString(data)
+		if n != len(want) || string(dst[:n]) != want {
+			t.Fatalf("size %d: got %q (n=%d), want %q", size, dst[:n], n, want)
+		}
+	}
+}
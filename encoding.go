@@ -0,0 +1,95 @@
+package ultrabase64
+
+import "encoding/base64"
+
+// Encoding - base64-алфавит, используемый EncodeMultithreaded/EncodeTo.
+// Паддинг (или его отсутствие) в финальном, не кратном 3 байтам хвосте
+// определяется паддингом того *base64.Encoding, которым Encoding была
+// создана; внутренние, кратные 3 байтам чанки всегда кодируются без
+// паддинга, потому что он им в принципе не нужен.
+type Encoding struct {
+	full *base64.Encoding // как задал вызывающий - применяется к хвосту
+	raw  *base64.Encoding // тот же алфавит без паддинга - применяется к чанкам
+}
+
+// NewEncoding оборачивает enc (стандартный, URL-safe или кастомный алфавит,
+// см. base64.NewEncoding) в Encoding, пригодную для Multithreaded/EncodeTo.
+func NewEncoding(enc *base64.Encoding) *Encoding {
+	return &Encoding{full: enc, raw: enc.WithPadding(base64.NoPadding)}
+}
+
+var (
+	// StdEncoding - стандартный алфавит RFC 4648 с паддингом.
+	StdEncoding = NewEncoding(base64.StdEncoding)
+	// URLEncoding - URL-safe алфавит RFC 4648 с паддингом.
+	URLEncoding = NewEncoding(base64.URLEncoding)
+	// RawStdEncoding - стандартный алфавит без паддинга.
+	RawStdEncoding = NewEncoding(base64.RawStdEncoding)
+	// RawURLEncoding - URL-safe алфавит без паддинга.
+	RawURLEncoding = NewEncoding(base64.RawURLEncoding)
+)
+
+// EncodedLen возвращает длину кодирования n байт входа данной Encoding.
+func (e *Encoding) EncodedLen(n int) int {
+	mainLen := n - n%3
+	total := mainLen / 3 * 4
+	if tailLen := n - mainLen; tailLen > 0 {
+		total += e.full.EncodedLen(tailLen)
+	}
+	return total
+}
+
+// EncodeTo кодирует src в dst (который должен быть не короче e.EncodedLen(len(src)))
+// воркер-пулом из numThreads горутин и возвращает число записанных байт.
+// Если кратная 3 байтам часть src короче MULTITHREAD_THRESHOLD, она
+// кодируется в вызывающей горутине, без воркеров.
+// numThreads <= 0 трактуется как "как Encode" (см. optimalThreads), а не как
+// ошибка.
+func (e *Encoding) EncodeTo(dst, src []byte, numThreads int) int {
+	inputLen := len(src)
+	if inputLen == 0 {
+		return 0
+	}
+	if numThreads <= 0 {
+		numThreads = optimalThreads()
+	}
+
+	remainderLen := inputLen % 3
+	mainPartLen := inputLen - remainderLen
+	mainPart := src[:mainPartLen]
+	tailPart := src[mainPartLen:]
+
+	if mainPartLen >= MULTITHREAD_THRESHOLD {
+		chunkSize := adaptiveChunkSize(currentCacheConfig())
+		encodeTilesInto(dst, mainPart, numThreads, chunkSize, e.raw)
+	} else {
+		e.raw.Encode(dst, mainPart)
+	}
+
+	n := mainPartLen / 3 * 4
+	if len(tailPart) > 0 {
+		e.full.Encode(dst[n:], tailPart)
+		n += e.full.EncodedLen(len(tailPart))
+	}
+	return n
+}
+
+// Multithreaded кодирует input в base64 этой Encoding, распараллеливая работу
+// на numThreads воркеров для входов не меньше MULTITHREAD_THRESHOLD.
+// numThreads <= 0 трактуется как "как Encode" (см. optimalThreads), а не как
+// ошибка.
+func (e *Encoding) Multithreaded(input []byte, numThreads int) string {
+	if len(input) == 0 {
+		return ""
+	}
+	if len(input)-len(input)%3 < MULTITHREAD_THRESHOLD {
+		return e.full.EncodeToString(input)
+	}
+	if numThreads <= 0 {
+		numThreads = optimalThreads()
+	}
+
+	result := make([]byte, e.EncodedLen(len(input)))
+	e.EncodeTo(result, input, numThreads)
+	return string(result)
+}
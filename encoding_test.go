@@ -0,0 +1,70 @@
+package ultrabase64
+
+import (
+	"encoding/base64"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodingMultithreadedMatchesStdlib(t *testing.T) {
+	cases := []struct {
+		name string
+		enc  *Encoding
+		std  *base64.Encoding
+	}{
+		{"Std", StdEncoding, base64.StdEncoding},
+		{"URL", URLEncoding, base64.URLEncoding},
+		{"RawStd", RawStdEncoding, base64.RawStdEncoding},
+		{"RawURL", RawURLEncoding, base64.RawURLEncoding},
+	}
+
+	// MULTITHREAD_THRESHOLD+1,+2,+3 покрывают все три варианта остатка хвоста.
+	sizes := []int{1, 100, MULTITHREAD_THRESHOLD + 1, MULTITHREAD_THRESHOLD + 2, MULTITHREAD_THRESHOLD + 3}
+
+	for _, c := range cases {
+		for _, size := range sizes {
+			data := make([]byte, size)
+			rand.New(rand.NewSource(int64(size))).Read(data)
+
+			got := c.enc.Multithreaded(data, 4)
+			want := c.std.EncodeToString(data)
+			if got != want {
+				t.Fatalf("%s size %d: mismatch (got len %d, want len %d)", c.name, size, len(got), len(want))
+			}
+			if decoded, err := c.std.DecodeString(got); err != nil || string(decoded) != string(data) {
+				t.Fatalf("%s size %d: output does not decode back to input: %v", c.name, size, err)
+			}
+		}
+	}
+}
+
+func TestMultithreadedZeroOrNegativeThreads(t *testing.T) {
+	data := make([]byte, MULTITHREAD_THRESHOLD+2)
+	rand.New(rand.NewSource(42)).Read(data)
+	want := base64.StdEncoding.EncodeToString(data)
+
+	for _, n := range []int{0, -1, -4} {
+		if got := StdEncoding.Multithreaded(data, n); got != want {
+			t.Fatalf("numThreads=%d: mismatch (got len %d, want len %d)", n, len(got), len(want))
+		}
+
+		dst := make([]byte, StdEncoding.EncodedLen(len(data)))
+		if n2 := StdEncoding.EncodeTo(dst, data, n); n2 != len(dst) || string(dst) != want {
+			t.Fatalf("EncodeTo numThreads=%d: mismatch (wrote %d, want len %d)", n, n2, len(want))
+		}
+	}
+}
+
+func TestCustomAlphabet(t *testing.T) {
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	custom := NewEncoding(base64.NewEncoding(alphabet))
+
+	data := make([]byte, MULTITHREAD_THRESHOLD+2)
+	rand.New(rand.NewSource(99)).Read(data)
+
+	got := custom.Multithreaded(data, 4)
+	want := base64.NewEncoding(alphabet).EncodeToString(data)
+	if got != want {
+		t.Fatalf("custom alphabet mismatch: got len %d, want len %d", len(got), len(want))
+	}
+}
@@ -0,0 +1,37 @@
+// Package ultrabase64 реализует многопоточное base64-кодирование больших
+// объёмов данных (аналог экспериментальной Rust-версии с Rayon).
+package ultrabase64
+
+import "runtime"
+
+const (
+	// Те же константы что и в Rust версии
+	MIN_CHUNK_SIZE        = 1024 * 1024 // 1MB
+	MULTITHREAD_THRESHOLD = 2 * MIN_CHUNK_SIZE
+	MAX_THREADS           = 8
+)
+
+// EncodeMultithreaded - аналог Rust функции encode_multithreaded, использует
+// StdEncoding. Для других алфавитов см. Encoding.Multithreaded.
+func EncodeMultithreaded(input []byte, numThreads int) string {
+	return StdEncoding.Multithreaded(input, numThreads)
+}
+
+// Encode - основная функция (аналог encode() в Rust)
+func Encode(input []byte) string {
+	if len(input) < MULTITHREAD_THRESHOLD {
+		return StdEncoding.full.EncodeToString(input)
+	}
+
+	return EncodeMultithreaded(input, optimalThreads())
+}
+
+// optimalThreads возвращает число воркеров, которое использует Encode и
+// EncodeStream по умолчанию: число ядер, ограниченное MAX_THREADS.
+func optimalThreads() int {
+	n := runtime.NumCPU()
+	if n > MAX_THREADS {
+		n = MAX_THREADS
+	}
+	return n
+}